@@ -0,0 +1,79 @@
+// Package common holds auth0_client_grant and auth0_custom_domain logic
+// that is shared between the SDKv2 provider (internal/provider) and the
+// terraform-plugin-framework provider (internal/provider/framework), so
+// that migrating a resource from one to the other doesn't require
+// duplicating or re-deriving it.
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auth0/go-auth0/management"
+)
+
+// ParseClientGrantImportID splits a "client_id:audience" composite import ID
+// into its parts. Audiences are URLs and may themselves contain colons, so
+// only the first colon is treated as the separator.
+func ParseClientGrantImportID(id string) (clientID, audience string, ok bool) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// FindClientGrantByClientAndAudience looks up the single client grant
+// matching the given client_id and audience. It is used both to import a
+// client grant by composite key and to back the auth0_client_grant data
+// source, since grant IDs are opaque and not surfaced in the Auth0
+// dashboard for most users.
+func FindClientGrantByClientAndAudience(api *management.Management, clientID, audience string) (*management.ClientGrant, error) {
+	found, err := listClientGrantsByClientAndAudience(api, clientID, audience)
+	if err != nil {
+		return nil, err
+	}
+
+	return exactlyOneClientGrant(found, clientID, audience)
+}
+
+// exactlyOneClientGrant validates that a client_id/audience lookup matched
+// precisely one client grant, since that pair is expected to be unique but
+// isn't enforced as such by the API.
+func exactlyOneClientGrant(found []*management.ClientGrant, clientID, audience string) (*management.ClientGrant, error) {
+	if len(found) == 0 {
+		return nil, fmt.Errorf("no client grant found for client_id %q and audience %q", clientID, audience)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("found %d client grants for client_id %q and audience %q, expected exactly one", len(found), clientID, audience)
+	}
+
+	return found[0], nil
+}
+
+// listClientGrantsByClientAndAudience returns every client grant matching
+// the given client_id and audience, following pagination until exhausted.
+func listClientGrantsByClientAndAudience(api *management.Management, clientID, audience string) ([]*management.ClientGrant, error) {
+	var found []*management.ClientGrant
+	page := 0
+	for {
+		clientGrants, err := api.ClientGrant.List(
+			management.Parameter("client_id", clientID),
+			management.Parameter("audience", audience),
+			management.Page(page),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		found = append(found, clientGrants.ClientGrants...)
+
+		if !clientGrants.HasNext() {
+			break
+		}
+		page++
+	}
+
+	return found, nil
+}
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+)
+
+func TestParseClientGrantImportID(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		wantClientID string
+		wantAudience string
+		wantOK       bool
+	}{
+		{
+			name:         "plain grant ID",
+			id:           "cgr_abc123",
+			wantClientID: "",
+			wantAudience: "",
+			wantOK:       false,
+		},
+		{
+			name:         "composite key",
+			id:           "client123:https://api.example.com",
+			wantClientID: "client123",
+			wantAudience: "https://api.example.com",
+			wantOK:       true,
+		},
+		{
+			name:         "composite key with colons in the audience",
+			id:           "client123:https://api.example.com:8080/resource",
+			wantClientID: "client123",
+			wantAudience: "https://api.example.com:8080/resource",
+			wantOK:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientID, audience, ok := ParseClientGrantImportID(tt.id)
+			if clientID != tt.wantClientID || audience != tt.wantAudience || ok != tt.wantOK {
+				t.Errorf("ParseClientGrantImportID(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.id, clientID, audience, ok, tt.wantClientID, tt.wantAudience, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestExactlyOneClientGrant(t *testing.T) {
+	grantA := &management.ClientGrant{ID: auth0.String("cgr_a")}
+	grantB := &management.ClientGrant{ID: auth0.String("cgr_b")}
+
+	tests := []struct {
+		name    string
+		found   []*management.ClientGrant
+		want    *management.ClientGrant
+		wantErr bool
+	}{
+		{
+			name:    "no matches is an error",
+			found:   nil,
+			wantErr: true,
+		},
+		{
+			name:  "exactly one match",
+			found: []*management.ClientGrant{grantA},
+			want:  grantA,
+		},
+		{
+			name:    "more than one match is an error",
+			found:   []*management.ClientGrant{grantA, grantB},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exactlyOneClientGrant(tt.found, "client123", "https://api.example.com")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("exactlyOneClientGrant() = %v, nil, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("exactlyOneClientGrant() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("exactlyOneClientGrant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
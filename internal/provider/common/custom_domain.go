@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+)
+
+const (
+	// CustomDomainVerificationInitialBackoff is the delay before the first
+	// re-poll of a custom domain's verification status.
+	CustomDomainVerificationInitialBackoff = 5 * time.Second
+	// CustomDomainVerificationMaxBackoff is the cap the backoff between
+	// polls grows to.
+	CustomDomainVerificationMaxBackoff = 60 * time.Second
+)
+
+// IsDNSNotYetPropagated reports whether err from CustomDomain.Verify is a
+// 400, which the API returns when the requested DNS records haven't
+// propagated yet. That's expected during polling and should be retried
+// rather than treated as a failure.
+func IsDNSNotYetPropagated(err error) bool {
+	mErr, ok := err.(management.Error)
+	return ok && mErr.Status() == http.StatusBadRequest
+}
+
+// PollCustomDomainVerification calls the Verify endpoint for the given
+// custom domain ID until its status reaches "ready" or ctx is done. It
+// backs off exponentially between polls, starting at
+// CustomDomainVerificationInitialBackoff and capping at
+// CustomDomainVerificationMaxBackoff. Callers are expected to derive ctx
+// from a resource's create (or update) timeout.
+func PollCustomDomainVerification(ctx context.Context, api *management.Management, id string) error {
+	backoff := CustomDomainVerificationInitialBackoff
+	for {
+		customDomain, err := api.CustomDomain.Verify(id)
+		if err != nil {
+			if !IsDNSNotYetPropagated(err) {
+				return err
+			}
+		} else if auth0.StringValue(customDomain.Status) == "ready" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for custom domain %q to become ready: %w", id, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > CustomDomainVerificationMaxBackoff {
+			backoff = CustomDomainVerificationMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type fakeManagementError struct {
+	status int
+}
+
+func (e fakeManagementError) Error() string { return "fake management error" }
+func (e fakeManagementError) Status() int   { return e.status }
+
+func TestIsDNSNotYetPropagated(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "400 from the API means DNS hasn't propagated yet",
+			err:  fakeManagementError{status: http.StatusBadRequest},
+			want: true,
+		},
+		{
+			name: "404 from the API is a real failure",
+			err:  fakeManagementError{status: http.StatusNotFound},
+			want: false,
+		},
+		{
+			name: "non-management error is a real failure",
+			err:  errors.New("connection reset"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDNSNotYetPropagated(tt.err); got != tt.want {
+				t.Errorf("IsDNSNotYetPropagated(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
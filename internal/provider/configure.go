@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func configure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	domain := d.Get("domain").(string)
+	clientID := d.Get("client_id").(string)
+	clientSecret := d.Get("client_secret").(string)
+
+	api, err := management.New(
+		domain,
+		management.WithClientCredentials(ctx, clientID, clientSecret),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return api, nil
+}
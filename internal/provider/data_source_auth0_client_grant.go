@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/maroux/terraform-provider-auth0/internal/provider/common"
+)
+
+// newClientGrantDataSource allows looking up a client grant provisioned
+// outside of this Terraform configuration (e.g. by another team or the
+// Auth0 dashboard) by its (client_id, audience) pair, without having to
+// import it into state.
+func newClientGrantDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readClientGrantForDataSource,
+		Description: "Data source to retrieve a specific client grant by client_id and audience.",
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the client for this grant.",
+			},
+			"audience": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Audience or API Identifier for this grant.",
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Permissions (scopes) included in this grant.",
+			},
+		},
+	}
+}
+
+func readClientGrantForDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	clientID := d.Get("client_id").(string)
+	audience := d.Get("audience").(string)
+
+	clientGrant, err := common.FindClientGrantByClientAndAudience(api, clientID, audience)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(auth0.StringValue(clientGrant.ID))
+
+	result := multierror.Append(
+		d.Set("client_id", clientGrant.ClientID),
+		d.Set("audience", clientGrant.Audience),
+		d.Set("scope", clientGrant.Scope),
+	)
+
+	return diag.FromErr(result.ErrorOrNil())
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newCustomDomainDataSource allows looking up a custom domain provisioned
+// outside of this Terraform configuration (e.g. by another team or the
+// Auth0 dashboard) by its domain name, without having to import it into
+// state.
+func newCustomDomainDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readCustomDomainForDataSource,
+		Description: "Data source to retrieve a specific custom domain by domain name.",
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the custom domain.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provisioning type for the custom domain.",
+			},
+			"primary": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether this is a primary domain.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Configuration status for the custom domain.",
+			},
+			"origin_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Once the configuration status is `ready`, the DNS name " +
+					"of the Auth0 origin server that handles traffic for the custom domain.",
+			},
+		},
+	}
+}
+
+func readCustomDomainForDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	domain := d.Get("domain").(string)
+
+	customDomains, err := api.CustomDomain.List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found []*management.CustomDomain
+	for _, customDomain := range customDomains {
+		if auth0.StringValue(customDomain.Domain) == domain {
+			found = append(found, customDomain)
+		}
+	}
+
+	if len(found) == 0 {
+		return diag.Errorf("no custom domain found for domain %q", domain)
+	}
+	if len(found) > 1 {
+		return diag.Errorf("found %d custom domains for domain %q, expected exactly one", len(found), domain)
+	}
+
+	customDomain := found[0]
+	d.SetId(auth0.StringValue(customDomain.ID))
+
+	result := multierror.Append(
+		d.Set("domain", customDomain.Domain),
+		d.Set("type", customDomain.Type),
+		d.Set("primary", customDomain.Primary),
+		d.Set("status", customDomain.Status),
+		d.Set("origin_domain_name", customDomain.OriginDomainName),
+	)
+
+	return diag.FromErr(result.ErrorOrNil())
+}
@@ -0,0 +1,122 @@
+// Package framework contains the terraform-plugin-framework implementation
+// of the auth0 provider. It is served alongside the SDKv2 implementation in
+// internal/provider via a mux server so that resources can be migrated one
+// at a time without breaking existing configurations.
+package framework
+
+import (
+	"context"
+	"os"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Auth0Provider is the terraform-plugin-framework implementation of the
+// auth0 provider. Use New to construct one.
+type Auth0Provider struct{}
+
+var _ provider.Provider = (*Auth0Provider)(nil)
+
+// New returns the terraform-plugin-framework implementation of the auth0
+// provider.
+func New() provider.Provider {
+	return &Auth0Provider{}
+}
+
+type providerModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+func (p *Auth0Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "auth0"
+}
+
+func (p *Auth0Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Your Auth0 domain name. Can also be set via the `AUTH0_DOMAIN` environment variable.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Your Auth0 client ID. Can also be set via the `AUTH0_CLIENT_ID` environment variable.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Your Auth0 client secret. Can also be set via the `AUTH0_CLIENT_SECRET` environment variable.",
+			},
+		},
+	}
+}
+
+func (p *Auth0Provider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config providerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := stringOrEnv(config.Domain, "AUTH0_DOMAIN")
+	clientID := stringOrEnv(config.ClientID, "AUTH0_CLIENT_ID")
+	clientSecret := stringOrEnv(config.ClientSecret, "AUTH0_CLIENT_SECRET")
+
+	if domain == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("domain"), "Missing Auth0 domain",
+			"Set the domain attribute or the AUTH0_DOMAIN environment variable.")
+	}
+	if clientID == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_id"), "Missing Auth0 client ID",
+			"Set the client_id attribute or the AUTH0_CLIENT_ID environment variable.")
+	}
+	if clientSecret == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_secret"), "Missing Auth0 client secret",
+			"Set the client_secret attribute or the AUTH0_CLIENT_SECRET environment variable.")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := management.New(
+		domain,
+		management.WithClientCredentials(ctx, clientID, clientSecret),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create Auth0 management client", err.Error())
+		return
+	}
+
+	resp.ResourceData = api
+	resp.DataSourceData = api
+}
+
+func (p *Auth0Provider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newClientGrantResource,
+		newCustomDomainResource,
+	}
+}
+
+func (p *Auth0Provider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// stringOrEnv returns the configured value if set, falling back to the given
+// environment variable the same way the SDKv2 provider's schema.EnvDefaultFunc
+// does for its equivalent attribute.
+func stringOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(envVar)
+}
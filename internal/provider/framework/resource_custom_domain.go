@@ -0,0 +1,327 @@
+package framework
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/auth0/go-auth0"
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/maroux/terraform-provider-auth0/internal/provider/common"
+)
+
+// customDomainCreateTimeoutDefault is the default create timeout, the same
+// as the auth0_custom_domain_verification resource's default create timeout.
+const customDomainCreateTimeoutDefault = 15 * time.Minute
+
+type customDomainResource struct {
+	api *management.Management
+}
+
+var _ resource.Resource = (*customDomainResource)(nil)
+var _ resource.ResourceWithConfigure = (*customDomainResource)(nil)
+var _ resource.ResourceWithImportState = (*customDomainResource)(nil)
+
+func newCustomDomainResource() resource.Resource {
+	return &customDomainResource{}
+}
+
+type customDomainModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Domain               types.String   `tfsdk:"domain"`
+	Type                 types.String   `tfsdk:"type"`
+	Primary              types.Bool     `tfsdk:"primary"`
+	Status               types.String   `tfsdk:"status"`
+	OriginDomainName     types.String   `tfsdk:"origin_domain_name"`
+	TLSPolicy            types.String   `tfsdk:"tls_policy"`
+	CustomClientIPHeader types.String   `tfsdk:"custom_client_ip_header"`
+	DomainMetadata       types.Map      `tfsdk:"domain_metadata"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *customDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+func (r *customDomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "With Auth0, you can use a custom domain to maintain a consistent user experience. " +
+			"This resource allows you to create and manage a custom domain within your Auth0 tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the custom domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Provisioning type for the custom domain. Options include `auth0_managed_certs` and `self_managed_certs`.",
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("auth0_managed_certs", "self_managed_certs"),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"primary": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether this is a primary domain.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Configuration status for the custom domain. Options include `disabled`, `pending`, `pending_verification`, and `ready`.",
+			},
+			"origin_domain_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Once the configuration status is `ready`, the DNS name of the Auth0 origin server that handles traffic for the custom domain.",
+			},
+			"tls_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "TLS policy for the custom domain. Options include `recommended` (for up-to-date clients) and `compatible` (for legacy clients that require an older cipher suite).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("recommended", "compatible"),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"custom_client_ip_header": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Custom headers to be used for the custom domain. Options include `true-client-ip`, `cf-connecting-ip`, `x-forwarded-for`, or empty string to unset.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("true-client-ip", "cf-connecting-ip", "x-forwarded-for", ""),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain_metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Metadata associated with the custom domain.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *customDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*management.Management)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource configure type", "expected *management.Management")
+		return
+	}
+
+	r.api = api
+}
+
+func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan customDomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, customDomainCreateTimeoutDefault)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, diags := domainMetadataToAPI(ctx, plan.DomainMetadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customDomain := &management.CustomDomain{
+		Domain:               auth0.String(plan.Domain.ValueString()),
+		Type:                 auth0.String(plan.Type.ValueString()),
+		TLSPolicy:            stringPtrOrNil(plan.TLSPolicy),
+		CustomClientIPHeader: stringPtrOrNil(plan.CustomClientIPHeader),
+		DomainMetadata:       metadata,
+	}
+
+	if err := r.api.CustomDomain.Create(customDomain); err != nil {
+		resp.Diagnostics.AddError("Unable to create custom domain", err.Error())
+		return
+	}
+
+	id := auth0.StringValue(customDomain.ID)
+	plan.ID = types.StringValue(id)
+
+	pollCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := common.PollCustomDomainVerification(pollCtx, r.api, id); err != nil {
+		resp.Diagnostics.AddError("Unable to verify custom domain", err.Error())
+	}
+
+	customDomain, err := r.api.CustomDomain.Read(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read custom domain", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setCustomDomainModel(ctx, &plan, customDomain)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *customDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state customDomainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customDomain, err := r.api.CustomDomain.Read(state.ID.ValueString())
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read custom domain", err.Error())
+		return
+	}
+
+	state.Domain = types.StringValue(auth0.StringValue(customDomain.Domain))
+	state.Type = types.StringValue(auth0.StringValue(customDomain.Type))
+
+	resp.Diagnostics.Append(setCustomDomainModel(ctx, &state, customDomain)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan customDomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata, diags := domainMetadataToAPI(ctx, plan.DomainMetadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if metadata == nil {
+		// An absent domain_metadata block must still clear any metadata the
+		// domain previously had, so send an empty map rather than omitting
+		// the field.
+		metadata = map[string]interface{}{}
+	}
+
+	customDomain := &management.CustomDomain{
+		TLSPolicy:            stringPtrOrNil(plan.TLSPolicy),
+		CustomClientIPHeader: stringPtrOrNil(plan.CustomClientIPHeader),
+		DomainMetadata:       metadata,
+	}
+
+	if err := r.api.CustomDomain.Update(plan.ID.ValueString(), customDomain); err != nil {
+		resp.Diagnostics.AddError("Unable to update custom domain", err.Error())
+		return
+	}
+
+	updated, err := r.api.CustomDomain.Read(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read custom domain", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setCustomDomainModel(ctx, &plan, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customDomainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.api.CustomDomain.Delete(state.ID.ValueString()); err != nil {
+		if mErr, ok := err.(management.Error); ok && mErr.Status() == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Unable to delete custom domain", err.Error())
+	}
+}
+
+func (r *customDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCustomDomainModel copies the computed/optional-computed fields of a
+// management.CustomDomain into the model, shared by Create/Read/Update so
+// state always reflects whatever the API actually stored.
+func setCustomDomainModel(ctx context.Context, model *customDomainModel, customDomain *management.CustomDomain) diag.Diagnostics {
+	model.Primary = types.BoolValue(auth0.BoolValue(customDomain.Primary))
+	model.Status = types.StringValue(auth0.StringValue(customDomain.Status))
+	model.OriginDomainName = types.StringValue(auth0.StringValue(customDomain.OriginDomainName))
+	model.TLSPolicy = types.StringValue(auth0.StringValue(customDomain.TLSPolicy))
+	model.CustomClientIPHeader = types.StringValue(auth0.StringValue(customDomain.CustomClientIPHeader))
+
+	metadata, diags := domainMetadataFromAPI(ctx, customDomain.DomainMetadata)
+	model.DomainMetadata = metadata
+
+	return diags
+}
+
+// stringPtrOrNil returns nil for a null/unknown attribute so the API field
+// is omitted rather than overwritten with an empty string.
+func stringPtrOrNil(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return auth0.String(v.ValueString())
+}
+
+// domainMetadataToAPI converts the domain_metadata attribute to the shape
+// management.CustomDomain expects, returning nil if the attribute wasn't
+// set at all.
+func domainMetadataToAPI(ctx context.Context, m types.Map) (map[string]interface{}, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+
+	var metadata map[string]string
+	diags := m.ElementsAs(ctx, &metadata, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+
+	return result, nil
+}
+
+// domainMetadataFromAPI converts a custom domain's metadata back into the
+// domain_metadata attribute's map type.
+func domainMetadataFromAPI(ctx context.Context, metadata map[string]interface{}) (types.Map, diag.Diagnostics) {
+	strs := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if s, ok := v.(string); ok {
+			strs[k] = s
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, strs)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// New returns the SDKv2 implementation of the auth0 provider. It backs the
+// resources and data sources that have not yet been migrated to
+// terraform-plugin-framework; see internal/provider/framework for those.
+//
+// auth0_client_grant and auth0_custom_domain are served by the framework
+// provider now, so they are deliberately absent from ResourcesMap: muxing
+// requires each resource type name to be owned by exactly one underlying
+// server. Their data sources have not been migrated yet and remain here.
+func New() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_DOMAIN", nil),
+				Description: "Your Auth0 domain name.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_ID", nil),
+				Description: "Your Auth0 client ID.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_SECRET", nil),
+				Description: "Your Auth0 client secret.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"auth0_custom_domain_verification": newCustomDomainVerification(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"auth0_client_grant":  newClientGrantDataSource(),
+			"auth0_custom_domain": newCustomDomainDataSource(),
+		},
+		ConfigureContextFunc: configure,
+	}
+}
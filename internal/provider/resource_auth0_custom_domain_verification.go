@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/auth0/go-auth0/management"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/maroux/terraform-provider-auth0/internal/provider/common"
+)
+
+// newCustomDomainVerification mirrors the split used by other providers
+// between domain creation and domain verification: creating an
+// auth0_custom_domain leaves it in "pending_verification" until the
+// requested DNS records exist, so verification is modeled as its own
+// resource that can be applied again once those records have propagated.
+func newCustomDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createCustomDomainVerification,
+		ReadContext:   readCustomDomainVerification,
+		DeleteContext: deleteCustomDomainVerification,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Description: "This resource allows you to trigger verification of an auth0_custom_domain. " +
+			"Domain creation and verification are separate lifecycles: apply the auth0_custom_domain first, " +
+			"create the DNS records it reports out-of-band, then apply this resource to wait for Auth0 to " +
+			"confirm them.",
+		Schema: map[string]*schema.Schema{
+			"custom_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the custom domain to verify.",
+			},
+			"cname_api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CNAME API key generated for the domain, used for self-managed certificates.",
+			},
+			"origin_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Once the configuration status is `ready`, the DNS name " +
+					"of the Auth0 origin server that handles traffic for the custom domain.",
+			},
+			"verification_method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain verification method, either `cname` or `txt`.",
+			},
+		},
+	}
+}
+
+func createCustomDomainVerification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	customDomainID := d.Get("custom_domain_id").(string)
+
+	d.SetId(customDomainID)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := common.PollCustomDomainVerification(ctx, api, customDomainID); err != nil {
+		return append(diag.FromErr(err), readCustomDomainVerification(ctx, d, m)...)
+	}
+
+	return readCustomDomainVerification(ctx, d, m)
+}
+
+func readCustomDomainVerification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*management.Management)
+	customDomain, err := api.CustomDomain.Read(d.Id())
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	result := multierror.Append(
+		d.Set("custom_domain_id", customDomain.ID),
+		d.Set("origin_domain_name", customDomain.OriginDomainName),
+	)
+
+	if customDomain.Verification != nil {
+		for _, method := range customDomain.Verification.Methods {
+			if name, ok := method["name"].(string); ok {
+				result = multierror.Append(result, d.Set("verification_method", name))
+			}
+			if key, ok := method["cname_api_key"].(string); ok {
+				result = multierror.Append(result, d.Set("cname_api_key", key))
+			}
+		}
+	}
+
+	return diag.FromErr(result.ErrorOrNil())
+}
+
+func deleteCustomDomainVerification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-mux/tf6to5server"
+
+	"github.com/maroux/terraform-provider-auth0/internal/provider"
+	"github.com/maroux/terraform-provider-auth0/internal/provider/framework"
+)
+
+// providerAddress is the registry address the provider is served under; it
+// must match the source address used in configurations' required_providers
+// blocks.
+const providerAddress = "registry.terraform.io/maroux/auth0"
+
+func main() {
+	ctx := context.Background()
+
+	sdkProvider := provider.New().GRPCProvider
+
+	frameworkProvider, err := tf6to5server.DowngradeServer(
+		ctx,
+		providerserver.NewProtocol6(framework.New()),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(
+		ctx,
+		sdkProvider,
+		func() tfprotov5.ProviderServer { return frameworkProvider },
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf5server.Serve(providerAddress, muxServer.ProviderServer()); err != nil {
+		log.Fatal(err)
+	}
+}